@@ -0,0 +1,200 @@
+package importlens
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/DEADSERPENT/importlens/deprecated"
+)
+
+// Analyze type-checks the Go package rooted at dir and reports its import
+// hygiene issues. dir must contain exactly one package (its test files, if
+// any, are included as part of that package; files belonging to an
+// external "_test" package are ignored).
+func Analyze(dir string) (*Report, error) {
+	bp, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("importlens: reading package %s: %w", dir, err)
+	}
+
+	names := make([]string, 0, len(bp.GoFiles)+len(bp.TestGoFiles))
+	names = append(names, bp.GoFiles...)
+	names = append(names, bp.TestGoFiles...)
+	sort.Strings(names)
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("importlens: parsing %s: %w", name, err)
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{
+		Defs:      make(map[*ast.Ident]types.Object),
+		Uses:      make(map[*ast.Ident]types.Object),
+		Implicits: make(map[ast.Node]types.Object),
+	}
+	cfg := types.Config{Importer: newSrcImporter(fset, dir), Error: func(error) {}}
+	// Keep going on type errors: a package with an unrelated compile error
+	// elsewhere shouldn't stop us from reporting its import hygiene.
+	_, _ = cfg.Check(bp.ImportPath, fset, files, info)
+
+	// build.Default.ImportDir isn't modules-aware, so bp.ImportPath is
+	// just "." for an ordinary module-rooted package; resolve the real
+	// import path from the enclosing go.mod so SelfImport can actually
+	// match something. ModulePath returns "" when that's not possible
+	// (e.g. a bare GOPATH-style directory), which disables the check.
+	pkgPath := deprecated.ModulePath(dir)
+
+	r := &Report{}
+	firstPos := make(map[string]token.Position)
+	firstTokenPos := make(map[string]token.Pos)
+	var importOrder []string
+	for _, f := range files {
+		r.Findings = append(r.Findings, DetectFile(fset, f, info, pkgPath)...)
+		for _, spec := range f.Imports {
+			path := importPath(spec)
+			if _, ok := firstPos[path]; ok {
+				continue
+			}
+			firstPos[path] = fset.Position(spec.Pos())
+			firstTokenPos[path] = spec.Pos()
+			importOrder = append(importOrder, path)
+		}
+	}
+
+	// Deprecated-module detection needs the local module cache, which
+	// isn't always present (e.g. a bare GOPATH-style directory); treat
+	// failure to resolve it as "nothing to report" rather than an error.
+	if deprecations, err := deprecated.Find(dir, importOrder); err == nil {
+		for _, d := range deprecations {
+			r.Findings = append(r.Findings, Finding{
+				Kind:     DeprecatedImport,
+				Import:   d.ImportPath,
+				Pos:      firstPos[d.ImportPath],
+				TokenPos: firstTokenPos[d.ImportPath],
+				Module:   d.Module,
+				Version:  d.Version,
+				Message:  d.Message,
+			})
+		}
+	}
+
+	return r, nil
+}
+
+// DetectFile finds import hygiene issues in a single already-parsed,
+// type-checked file. It's the primitive both Analyze and the go/analysis
+// pass in passes/importlens build on, so a caller that already has a
+// *token.FileSet, an *ast.File and its *types.Info (e.g. from an
+// analysis.Pass) doesn't need to go through Analyze's own file I/O.
+// pkgPath is the import path of the package file belongs to, used to
+// detect a package importing itself; pass "" to skip that check.
+func DetectFile(fset *token.FileSet, file *ast.File, info *types.Info, pkgPath string) []Finding {
+	var findings []Finding
+	seen := make(map[string]bool, len(file.Imports))
+
+	for _, spec := range file.Imports {
+		path := importPath(spec)
+		pos := fset.Position(spec.Pos())
+
+		if seen[path] {
+			findings = append(findings, Finding{Kind: DuplicateImport, Import: path, Pos: pos, TokenPos: spec.Pos()})
+		}
+		seen[path] = true
+
+		if pkgPath != "" && path == pkgPath {
+			findings = append(findings, Finding{Kind: SelfImport, Import: path, Pos: pos, TokenPos: spec.Pos()})
+		}
+
+		var pkgName *types.PkgName
+		if spec.Name != nil {
+			pkgName, _ = info.Defs[spec.Name].(*types.PkgName)
+		} else {
+			pkgName, _ = info.Implicits[spec].(*types.PkgName)
+		}
+		if pkgName == nil {
+			// Type-checking failed to resolve this import; nothing more we
+			// can say with confidence.
+			continue
+		}
+
+		switch {
+		case spec.Name != nil && spec.Name.Name == "_":
+			findings = append(findings, Finding{Kind: BlankImport, Import: path, LocalName: "_", Pos: pos, TokenPos: spec.Pos()})
+
+		case spec.Name != nil && spec.Name.Name == ".":
+			findings = append(findings, Finding{Kind: DotImport, Import: path, LocalName: ".", Pos: pos, TokenPos: spec.Pos()})
+			if !dotImportUsed(file, info, pkgName.Imported()) {
+				findings = append(findings, Finding{Kind: UnusedImport, Import: path, LocalName: pkgName.Name(), Pos: pos, TokenPos: spec.Pos()})
+			}
+
+		default:
+			if !identUsed(file, info, pkgName) {
+				kind := UnusedImport
+				if spec.Name != nil {
+					kind = UnusedAliasedImport
+				}
+				findings = append(findings, Finding{Kind: kind, Import: path, LocalName: pkgName.Name(), Pos: pos, TokenPos: spec.Pos()})
+			}
+		}
+	}
+	return findings
+}
+
+// identUsed reports whether some identifier in f resolves to pkgName,
+// i.e. the import's qualifier was actually referenced as such. A local
+// declaration that shadows the package name (e.g. a variable called fmt)
+// resolves to that declaration instead, so it doesn't count as a use.
+func identUsed(f *ast.File, info *types.Info, pkgName *types.PkgName) bool {
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if used {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok && info.Uses[id] == pkgName {
+			used = true
+		}
+		return true
+	})
+	return used
+}
+
+// dotImportUsed reports whether f refers to any name exported by pkg
+// without a qualifier, which is the only way a dot import can be used.
+func dotImportUsed(f *ast.File, info *types.Info, pkg *types.Package) bool {
+	if pkg == nil {
+		return false
+	}
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if used {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok {
+			if obj := info.Uses[id]; obj != nil && obj.Pkg() == pkg {
+				used = true
+			}
+		}
+		return true
+	})
+	return used
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	p, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return spec.Path.Value
+	}
+	return p
+}
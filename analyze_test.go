@@ -0,0 +1,126 @@
+package importlens
+
+import "testing"
+
+func TestAnalyzeBasic(t *testing.T) {
+	r, err := Analyze("testdata/basic")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	want := map[Kind]string{
+		UnusedImport: "time",
+		BlankImport:  "database/sql",
+	}
+	if len(r.Findings) != len(want) {
+		t.Fatalf("got %d findings, want %d: %+v", len(r.Findings), len(want), r.Findings)
+	}
+	for _, f := range r.Findings {
+		if want[f.Kind] != f.Import {
+			t.Errorf("unexpected finding %+v", f)
+		}
+	}
+}
+
+func TestAnalyzeShadowedQualifier(t *testing.T) {
+	r, err := Analyze("testdata/shadow")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	if len(r.Findings) != 1 {
+		t.Fatalf("got %d findings, want 1: %+v", len(r.Findings), r.Findings)
+	}
+	if got := r.Findings[0]; got.Kind != UnusedImport || got.Import != "fmt" {
+		t.Errorf("Findings[0] = %+v, want UnusedImport fmt", got)
+	}
+}
+
+func TestAnalyzeDotImport(t *testing.T) {
+	r, err := Analyze("testdata/dot")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if len(r.Findings) != 1 {
+		t.Fatalf("got %d findings, want 1: %+v", len(r.Findings), r.Findings)
+	}
+	if got := r.Findings[0]; got.Kind != DotImport || got.Import != "strings" {
+		t.Errorf("Findings[0] = %+v, want DotImport strings", got)
+	}
+}
+
+func TestAnalyzeCrossFile(t *testing.T) {
+	r, err := Analyze("testdata/crossfile")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	if len(r.Findings) != 0 {
+		t.Fatalf("got %d findings, want 0: %+v", len(r.Findings), r.Findings)
+	}
+}
+
+func TestAnalyzeDeprecatedImport(t *testing.T) {
+	t.Setenv("GOMODCACHE", "testdata/deprecated/modcache")
+
+	r, err := Analyze("testdata/deprecated/consumer/pkg")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	var dep *Finding
+	for i, f := range r.Findings {
+		if f.Kind == DeprecatedImport {
+			dep = &r.Findings[i]
+		}
+	}
+	if dep == nil {
+		t.Fatalf("no DeprecatedImport finding in %+v", r.Findings)
+	}
+	if dep.Module != "example.com/gone" || dep.Version != "v0.1.0" {
+		t.Errorf("Module/Version = %s@%s, want example.com/gone@v0.1.0", dep.Module, dep.Version)
+	}
+	if dep.Message != "no longer maintained." {
+		t.Errorf("Message = %q", dep.Message)
+	}
+}
+
+func TestAnalyzeDuplicateImport(t *testing.T) {
+	r, err := Analyze("testdata/duplicate")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	var dup int
+	for _, f := range r.Findings {
+		if f.Kind == DuplicateImport {
+			dup++
+			if f.Import != "fmt" {
+				t.Errorf("DuplicateImport.Import = %q, want fmt", f.Import)
+			}
+		}
+	}
+	if dup != 1 {
+		t.Fatalf("got %d DuplicateImport findings, want 1: %+v", dup, r.Findings)
+	}
+}
+
+func TestAnalyzeSelfImport(t *testing.T) {
+	r, err := Analyze("testdata/selfimport")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	const wantPath = "github.com/DEADSERPENT/importlens/testdata/selfimport"
+	var self int
+	for _, f := range r.Findings {
+		if f.Kind == SelfImport {
+			self++
+			if f.Import != wantPath {
+				t.Errorf("SelfImport.Import = %q, want %q", f.Import, wantPath)
+			}
+		}
+	}
+	if self != 1 {
+		t.Fatalf("got %d SelfImport findings, want 1: %+v", self, r.Findings)
+	}
+}
@@ -0,0 +1,34 @@
+// Command importlens-vet is go vet plus importlens: it runs the standard
+// vet analyzers alongside importlens's import hygiene checks, so CI can
+// run one binary instead of two.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+	"golang.org/x/tools/go/analysis/passes/atomic"
+	"golang.org/x/tools/go/analysis/passes/bools"
+	"golang.org/x/tools/go/analysis/passes/composite"
+	"golang.org/x/tools/go/analysis/passes/loopclosure"
+	"golang.org/x/tools/go/analysis/passes/nilfunc"
+	"golang.org/x/tools/go/analysis/passes/printf"
+	"golang.org/x/tools/go/analysis/passes/shadow"
+	"golang.org/x/tools/go/analysis/passes/structtag"
+	"golang.org/x/tools/go/analysis/passes/unreachable"
+
+	"github.com/DEADSERPENT/importlens/passes/importlens"
+)
+
+func main() {
+	multichecker.Main(
+		atomic.Analyzer,
+		bools.Analyzer,
+		composite.Analyzer,
+		loopclosure.Analyzer,
+		nilfunc.Analyzer,
+		printf.Analyzer,
+		shadow.Analyzer,
+		structtag.Analyzer,
+		unreachable.Analyzer,
+		importlens.Analyzer,
+	)
+}
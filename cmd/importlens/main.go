@@ -0,0 +1,105 @@
+// Command importlens is importlens's CLI: "importlens vet" runs the
+// go/analysis pass standalone (the same way any singlechecker-based vet
+// tool does), "importlens report" renders a Markdown import hygiene
+// report, optionally updating it in place inside an existing file, and
+// "importlens score" grades a whole module for use as a CI gate.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/DEADSERPENT/importlens"
+	pass "github.com/DEADSERPENT/importlens/passes/importlens"
+	"github.com/DEADSERPENT/importlens/report/markdown"
+	"github.com/DEADSERPENT/importlens/score"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, args := os.Args[1], os.Args[2:]
+	switch cmd {
+	case "vet":
+		os.Args = append([]string{os.Args[0]}, args...)
+		singlechecker.Main(pass.Analyzer)
+	case "report":
+		if err := runReport(args); err != nil {
+			fmt.Fprintln(os.Stderr, "importlens report:", err)
+			os.Exit(1)
+		}
+	case "score":
+		if err := runScore(args); err != nil {
+			fmt.Fprintln(os.Stderr, "importlens score:", err)
+			os.Exit(1)
+		}
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	format := fs.String("format", "md", "output format (only \"md\" is supported)")
+	update := fs.String("update", "", "update this file's marker-bounded blocks in place instead of writing to stdout")
+	fs.Parse(args)
+
+	if *format != "md" {
+		return fmt.Errorf("unsupported -format %q", *format)
+	}
+	dir := "."
+	if fs.NArg() > 0 {
+		dir = fs.Arg(0)
+	}
+
+	rpt, err := importlens.Analyze(dir)
+	if err != nil {
+		return err
+	}
+
+	if *update != "" {
+		return markdown.Update(*update, rpt)
+	}
+	return markdown.Render(os.Stdout, rpt)
+}
+
+func runScore(args []string) error {
+	fs := flag.NewFlagSet("score", flag.ExitOnError)
+	config := fs.String("config", ".importlens.yaml", "path to the weight-configuration file")
+	fs.Parse(args)
+
+	dir := "."
+	if fs.NArg() > 0 {
+		dir = fs.Arg(0)
+	}
+
+	cfg, err := score.LoadConfig(*config)
+	if err != nil {
+		return err
+	}
+	result, err := score.Run(dir, cfg)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: importlens <command> [arguments]
+
+Commands:
+  vet [packages]                           run the importlens go/analysis pass
+  report [-format md] [-update f] [dir]    render an import hygiene report
+  score [-config f] [dir]                  grade a module and print a JSON scorecard`)
+}
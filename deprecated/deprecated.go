@@ -0,0 +1,229 @@
+// Package deprecated detects dependencies whose owning module has been
+// marked deprecated via the "// Deprecated: ..." comment convention that
+// golang.org/x/mod/modfile (and so `go list -m`) already recognizes on a
+// go.mod's module directive.
+//
+// Resolution is hermetic: it reads the consuming module's go.mod and
+// go.sum and the dependency's go.mod straight out of the local module
+// cache (GOMODCACHE, or its default under GOPATH). It never fetches
+// anything over the network, so it behaves the same whether or not
+// GOPROXY is set to "off".
+package deprecated
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+// Finding reports that importPath is served by a module whose go.mod
+// marks it deprecated.
+type Finding struct {
+	ImportPath string // the import path that led to Module
+	Module     string // the owning module's path
+	Version    string // the version of Module pinned by the consumer
+	Message    string // the deprecation notice, without the "Deprecated:" prefix
+}
+
+// Find checks each of importPaths against the module graph of the
+// module that contains dir, returning one Finding per distinct
+// deprecated module (so five sub-packages of the same deprecated module
+// produce one Finding, not five). Import paths that aren't resolvable
+// hermetically — not required by the consumer, replaced with a local
+// filesystem path, or simply not present in the local module cache — are
+// skipped rather than treated as an error.
+func Find(dir string, importPaths []string) ([]Finding, error) {
+	root, ok := findModuleRoot(dir)
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	mf, err := modfile.Parse(filepath.Join(root, "go.mod"), data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := requirements(mf)
+	sums := readGoSum(filepath.Join(root, "go.sum"))
+	cacheDir := modCacheDir()
+
+	var mainPath string
+	if mf.Module != nil {
+		mainPath = mf.Module.Mod.Path
+	}
+
+	var findings []Finding
+	seen := make(map[string]bool)
+	for _, ip := range importPaths {
+		if mainPath != "" && (ip == mainPath || strings.HasPrefix(ip, mainPath+"/")) {
+			continue // part of the module being analyzed, not a dependency
+		}
+
+		req, ok := ownerModule(reqs, ip)
+		if !ok || req.local || seen[req.cachePath] {
+			continue
+		}
+		seen[req.cachePath] = true
+
+		if len(sums) > 0 && !sums[req.cachePath+"@"+req.version] {
+			continue // can't confirm the pinned version hermetically
+		}
+
+		msg, ok := readDeprecation(cacheDir, req.cachePath, req.version)
+		if !ok {
+			continue
+		}
+		findings = append(findings, Finding{
+			ImportPath: ip,
+			Module:     req.cachePath,
+			Version:    req.version,
+			Message:    msg,
+		})
+	}
+	return findings, nil
+}
+
+// requirement is a resolved entry from the module graph: the module that
+// actually provides matchPath's import paths, after replace directives.
+type requirement struct {
+	cachePath string // module path to use for the cache dir and go.sum lookup
+	version   string
+	local     bool // replaced with a local filesystem path; not cache-checkable
+}
+
+func requirements(mf *modfile.File) map[string]requirement {
+	reqs := make(map[string]requirement, len(mf.Require))
+	for _, r := range mf.Require {
+		reqs[r.Mod.Path] = requirement{cachePath: r.Mod.Path, version: r.Mod.Version}
+	}
+	for _, rep := range mf.Replace {
+		cur, ok := reqs[rep.Old.Path]
+		if rep.Old.Version != "" && (!ok || cur.version != rep.Old.Version) {
+			continue // this replace targets a version we don't have
+		}
+		if rep.New.Version == "" {
+			reqs[rep.Old.Path] = requirement{local: true}
+			continue
+		}
+		reqs[rep.Old.Path] = requirement{cachePath: rep.New.Path, version: rep.New.Version}
+	}
+	return reqs
+}
+
+// ownerModule finds the module that provides importPath: the module
+// whose path is importPath itself, or a "/"-separated prefix of it.
+func ownerModule(reqs map[string]requirement, importPath string) (requirement, bool) {
+	var best string
+	for path := range reqs {
+		if path != importPath && !strings.HasPrefix(importPath, path+"/") {
+			continue
+		}
+		if len(path) > len(best) {
+			best = path
+		}
+	}
+	if best == "" {
+		return requirement{}, false
+	}
+	return reqs[best], true
+}
+
+func readGoSum(path string) map[string]bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	sums := make(map[string]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		version := strings.TrimSuffix(fields[1], "/go.mod")
+		sums[fields[0]+"@"+version] = true
+	}
+	return sums
+}
+
+func readDeprecation(cacheDir, modPath, version string) (string, bool) {
+	escaped, err := module.EscapePath(modPath)
+	if err != nil {
+		return "", false
+	}
+	gomod := filepath.Join(cacheDir, escaped+"@"+version, "go.mod")
+	data, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", false // not in the local cache; we don't fetch it
+	}
+	mf, err := modfile.ParseLax(gomod, data, nil)
+	if err != nil || mf.Module == nil || mf.Module.Deprecated == "" {
+		return "", false
+	}
+	return mf.Module.Deprecated, true
+}
+
+func modCacheDir() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	return filepath.Join(build.Default.GOPATH, "pkg", "mod")
+}
+
+// ModulePath returns the import path a package at dir would have inside
+// its enclosing module: the module's own path, plus dir's slash-joined
+// path relative to the module root. It returns "" if dir isn't inside a
+// module this can resolve (no enclosing go.mod, or a go.mod without a
+// module directive), the same "nothing to report" treatment Find gives
+// an unresolvable module graph.
+func ModulePath(dir string) string {
+	root, ok := findModuleRoot(dir)
+	if !ok {
+		return ""
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	mf, err := modfile.ParseLax(filepath.Join(root, "go.mod"), data, nil)
+	if err != nil || mf.Module == nil {
+		return ""
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == "." {
+		return mf.Module.Mod.Path
+	}
+	return mf.Module.Mod.Path + "/" + filepath.ToSlash(rel)
+}
+
+// findModuleRoot walks up from dir looking for the go.mod that makes it
+// a module.
+func findModuleRoot(dir string) (string, bool) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(abs, "go.mod")); err == nil {
+			return abs, true
+		}
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			return "", false
+		}
+		abs = parent
+	}
+}
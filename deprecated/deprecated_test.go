@@ -0,0 +1,33 @@
+package deprecated
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	t.Setenv("GOMODCACHE", "testdata/modcache")
+
+	got, err := Find("testdata/consumer", []string{"example.com/old/sub", "example.com/old/sub2", "fmt"})
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("got %d findings, want 1 (deduplicated): %+v", len(got), got)
+	}
+	f := got[0]
+	if f.Module != "example.com/old" || f.Version != "v1.2.3" {
+		t.Errorf("Module/Version = %s@%s, want example.com/old@v1.2.3", f.Module, f.Version)
+	}
+	if f.Message != "use example.com/new instead." {
+		t.Errorf("Message = %q", f.Message)
+	}
+}
+
+func TestFindNoModule(t *testing.T) {
+	got, err := Find(t.TempDir(), []string{"example.com/old"})
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if got != nil {
+		t.Errorf("got %+v, want nil for a directory with no go.mod", got)
+	}
+}
@@ -0,0 +1,10 @@
+// Package importlens analyzes Go packages for import hygiene issues:
+// unused imports, shadowed qualifiers, dot imports, and related problems
+// that plain string matching gets wrong.
+//
+// Analysis is performed with full type information, obtained by parsing
+// the target package and its dependencies with go/parser and type-checking
+// them with go/types. This lets importlens tell a genuinely unused import
+// apart from one whose package name merely happens to be shadowed by a
+// local identifier.
+package importlens
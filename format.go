@@ -0,0 +1,63 @@
+package importlens
+
+import "fmt"
+
+// FormatMode selects which compiler's diagnostic wording Format mimics.
+type FormatMode int
+
+const (
+	// GoCompilerMode mirrors cmd/compile's types2 checker (see
+	// errorUnusedPkg in cmd/compile/internal/types2/resolver.go): the
+	// quoted import path leads, with "as <alias>" inserted before "and
+	// not used" when the import was renamed.
+	GoCompilerMode FormatMode = iota
+	// GCCGoMode mirrors gccgo: diagnostics name the bare local identifier
+	// rather than the quoted import path.
+	GCCGoMode
+)
+
+// Format renders f the way the given compiler would, so output stays
+// grep-compatible with tooling already keyed off the compiler's wording.
+// It does not include the position; combine with f.Pos for a full
+// "file:line:col: message" diagnostic.
+func Format(f Finding, mode FormatMode) string {
+	switch f.Kind {
+	case UnusedImport:
+		if mode == GCCGoMode {
+			return fmt.Sprintf("imported and not used: %s", f.LocalName)
+		}
+		return fmt.Sprintf("%q imported and not used", f.Import)
+
+	case UnusedAliasedImport:
+		if mode == GCCGoMode {
+			return fmt.Sprintf("imported and not used: %s", f.LocalName)
+		}
+		return fmt.Sprintf("%q imported as %s and not used", f.Import, f.LocalName)
+
+	case BlankImport:
+		return fmt.Sprintf("blank import: %q", f.Import)
+
+	case DotImport:
+		return fmt.Sprintf("dot import: %q", f.Import)
+
+	case DuplicateImport:
+		// Not a real compiler diagnostic: Go happily compiles the same
+		// import path appearing twice (even under different local names),
+		// so there's no cmd/compile or gccgo wording to mirror here.
+		return fmt.Sprintf("%q imported more than once", f.Import)
+
+	case SelfImport:
+		return fmt.Sprintf("import cycle not allowed: package imports itself via %q", f.Import)
+
+	case DeprecatedImport:
+		return fmt.Sprintf("module %s@%s is deprecated: %s", f.Module, f.Version, f.Message)
+
+	default:
+		return fmt.Sprintf("unknown import issue: %q", f.Import)
+	}
+}
+
+// String formats f using GoCompilerMode, prefixed with its position.
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Pos, Format(f, GoCompilerMode))
+}
@@ -0,0 +1,69 @@
+package importlens
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Finding
+		mode FormatMode
+		want string
+	}{
+		{
+			name: "unused go",
+			f:    Finding{Kind: UnusedImport, Import: "time", LocalName: "time"},
+			mode: GoCompilerMode,
+			want: `"time" imported and not used`,
+		},
+		{
+			name: "unused gccgo",
+			f:    Finding{Kind: UnusedImport, Import: "time", LocalName: "time"},
+			mode: GCCGoMode,
+			want: "imported and not used: time",
+		},
+		{
+			name: "aliased unused go",
+			f:    Finding{Kind: UnusedAliasedImport, Import: "math", LocalName: "foo"},
+			mode: GoCompilerMode,
+			want: `"math" imported as foo and not used`,
+		},
+		{
+			name: "aliased unused gccgo",
+			f:    Finding{Kind: UnusedAliasedImport, Import: "math", LocalName: "foo"},
+			mode: GCCGoMode,
+			want: "imported and not used: foo",
+		},
+		{
+			name: "blank import",
+			f:    Finding{Kind: BlankImport, Import: "database/sql"},
+			mode: GoCompilerMode,
+			want: `blank import: "database/sql"`,
+		},
+		{
+			name: "dot import",
+			f:    Finding{Kind: DotImport, Import: "strings"},
+			mode: GoCompilerMode,
+			want: `dot import: "strings"`,
+		},
+		{
+			name: "duplicate import",
+			f:    Finding{Kind: DuplicateImport, Import: "fmt"},
+			mode: GoCompilerMode,
+			want: `"fmt" imported more than once`,
+		},
+		{
+			name: "self import",
+			f:    Finding{Kind: SelfImport, Import: "example.com/pkg"},
+			mode: GoCompilerMode,
+			want: `import cycle not allowed: package imports itself via "example.com/pkg"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.f, tt.mode); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package importlens
+
+// Kind classifies the kind of import hygiene issue a Finding describes.
+type Kind int
+
+const (
+	// UnusedImport is a plain import whose package name is never
+	// referenced in the file.
+	UnusedImport Kind = iota
+	// UnusedAliasedImport is an explicitly renamed import (import foo "math")
+	// whose alias is never referenced in the file.
+	UnusedAliasedImport
+	// BlankImport is an import kept only for its side effects (import _ "pkg").
+	// It's never "unused" by definition; importlens reports it so callers
+	// can choose to treat it as informational.
+	BlankImport
+	// DotImport is a dot import (import . "pkg"), which pulls the
+	// package's exported names into file scope unqualified.
+	DotImport
+	// DuplicateImport is an import path that appears more than once in
+	// the same file.
+	DuplicateImport
+	// SelfImport is a package importing its own import path.
+	SelfImport
+	// DeprecatedImport is an import served by a module whose go.mod
+	// marks it deprecated.
+	DeprecatedImport
+)
+
+func (k Kind) String() string {
+	switch k {
+	case UnusedImport:
+		return "UnusedImport"
+	case UnusedAliasedImport:
+		return "UnusedAliasedImport"
+	case BlankImport:
+		return "BlankImport"
+	case DotImport:
+		return "DotImport"
+	case DuplicateImport:
+		return "DuplicateImport"
+	case SelfImport:
+		return "SelfImport"
+	case DeprecatedImport:
+		return "DeprecatedImport"
+	default:
+		return "Kind(?)"
+	}
+}
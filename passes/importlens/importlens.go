@@ -0,0 +1,126 @@
+// Package importlens exposes importlens's import hygiene checks as a
+// go/analysis pass, so it can be dropped into go vet, golangci-lint, or a
+// custom multichecker alongside the standard analyzers.
+package importlens
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/DEADSERPENT/importlens"
+)
+
+const doc = `report unused, shadowed, dot, duplicate and self imports
+
+The importlens analyzer type-checks each file in the package and flags
+imports whose qualifier is never referenced (including one shadowed by a
+local identifier of the same name), dot and blank imports, import paths
+repeated in the same file, and a package importing its own import path.`
+
+// Analyzer is the importlens go/analysis pass.
+var Analyzer = &analysis.Analyzer{
+	Name: "importlens",
+	Doc:  doc,
+	Run:  run,
+	// An unused import is itself a type error, so the package under
+	// analysis will almost always have one; keep going regardless.
+	RunDespiteErrors: true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	pkgPath := ""
+	if pass.Pkg != nil {
+		pkgPath = pass.Pkg.Path()
+	}
+
+	for _, file := range pass.Files {
+		for _, f := range importlens.DetectFile(pass.Fset, file, pass.TypesInfo, pkgPath) {
+			diag := analysis.Diagnostic{
+				Pos:     f.TokenPos,
+				Message: importlens.Format(f, importlens.GoCompilerMode),
+			}
+			if fix, ok := removalFix(pass.Fset, file, f); ok {
+				diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+			}
+			pass.Report(diag)
+		}
+	}
+	return nil, nil
+}
+
+// removalFix builds a SuggestedFix that deletes the import spec f refers
+// to, collapsing its surrounding import block if that empties it. It
+// only applies to the two kinds that mean "this import should go away".
+func removalFix(fset *token.FileSet, file *ast.File, f importlens.Finding) (analysis.SuggestedFix, bool) {
+	if f.Kind != importlens.UnusedImport && f.Kind != importlens.UnusedAliasedImport {
+		return analysis.SuggestedFix{}, false
+	}
+
+	spec, decl := findImportSpec(file, f.TokenPos)
+	if spec == nil {
+		return analysis.SuggestedFix{}, false
+	}
+
+	start, end := spec.Pos(), spec.End()
+	if spec.Doc != nil {
+		start = spec.Doc.Pos()
+	}
+	if spec.Comment != nil {
+		end = spec.Comment.End()
+	}
+	if len(decl.Specs) == 1 {
+		// This is the only spec in the declaration: remove the whole
+		// thing (the grouped "import (...)" block, or the bare
+		// "import \"path\""), not just the spec, so the block collapses
+		// instead of being left empty.
+		start, end = decl.Pos(), decl.End()
+	}
+
+	tf := fset.File(start)
+	start = lineStart(tf, start)
+	end = lineEnd(tf, end)
+
+	return analysis.SuggestedFix{
+		Message: fmt.Sprintf("Remove import %q", f.Import),
+		TextEdits: []analysis.TextEdit{
+			{Pos: start, End: end, NewText: nil},
+		},
+	}, true
+}
+
+// findImportSpec returns the *ast.ImportSpec at pos and the *ast.GenDecl
+// it belongs to.
+func findImportSpec(file *ast.File, pos token.Pos) (*ast.ImportSpec, *ast.GenDecl) {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		for _, s := range gd.Specs {
+			if spec := s.(*ast.ImportSpec); spec.Pos() == pos {
+				return spec, gd
+			}
+		}
+	}
+	return nil, nil
+}
+
+// lineStart returns the position of the first character on pos's line,
+// so deleting up to there also removes its leading indentation.
+func lineStart(tf *token.File, pos token.Pos) token.Pos {
+	return tf.LineStart(tf.Line(pos))
+}
+
+// lineEnd returns the position just past pos's line terminator (or the
+// end of the file, if pos is on the last line), so deleting from there
+// also removes the trailing newline rather than leaving a blank line.
+func lineEnd(tf *token.File, pos token.Pos) token.Pos {
+	line := tf.Line(pos)
+	if line >= tf.LineCount() {
+		return tf.Pos(tf.Size())
+	}
+	return tf.LineStart(line + 1)
+}
@@ -0,0 +1,19 @@
+package importlens_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/DEADSERPENT/importlens/passes/importlens"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, importlens.Analyzer, "a")
+}
+
+func TestSuggestedFix(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, importlens.Analyzer, "b", "c", "d")
+}
@@ -0,0 +1,14 @@
+package a
+
+import (
+	"fmt"       // want `"fmt" imported and not used`
+	m "math"    // want `"math" imported as m and not used`
+	. "strings" // want `dot import: "strings"`
+	_ "unsafe"  // want `blank import: "unsafe"`
+)
+
+var upper = ToUpper("hi")
+
+func F() string {
+	return "x"
+}
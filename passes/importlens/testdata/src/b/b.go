@@ -0,0 +1,10 @@
+package b
+
+import (
+	"fmt" // want `"fmt" imported and not used`
+	"strings"
+)
+
+func F() string {
+	return strings.ToUpper("hi")
+}
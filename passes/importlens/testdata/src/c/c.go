@@ -0,0 +1,7 @@
+package c
+
+import "fmt" // want `"fmt" imported and not used`
+
+func F() string {
+	return "x"
+}
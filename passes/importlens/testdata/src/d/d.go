@@ -0,0 +1,11 @@
+package d
+
+import (
+	// deprecated, remove
+	"fmt" // want `"fmt" imported and not used`
+	"strings"
+)
+
+func F() string {
+	return strings.ToUpper("hi")
+}
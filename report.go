@@ -0,0 +1,36 @@
+package importlens
+
+import "go/token"
+
+// Report is the result of analyzing a package: every import hygiene
+// issue importlens found, in the order the files were processed.
+type Report struct {
+	Findings []Finding
+}
+
+// Finding describes a single import hygiene issue.
+type Finding struct {
+	Kind Kind
+
+	// Import is the import path, e.g. "math".
+	Import string
+	// LocalName is the identifier the import is known by in the file:
+	// the alias for a renamed import, "." for a dot import, "_" for a
+	// blank import, or the package's own name otherwise.
+	LocalName string
+
+	// Pos is the position of the import spec, resolved against the
+	// *token.FileSet the file was parsed with.
+	Pos token.Position
+	// TokenPos is the same position, unresolved. Callers that still have
+	// the originating *token.FileSet (such as a go/analysis pass) can use
+	// it directly with pass.Reportf instead of re-resolving Pos.
+	TokenPos token.Pos
+
+	// Module and Version identify the owning module of a DeprecatedImport
+	// finding. Message carries that module's deprecation notice. Unused
+	// by every other Kind.
+	Module  string
+	Version string
+	Message string
+}
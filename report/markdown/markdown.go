@@ -0,0 +1,222 @@
+// Package markdown renders an importlens.Report as Markdown, embedding
+// the exact offending import block for each file as a fenced code
+// snippet with the problem lines called out underneath.
+//
+// Snippets are wrapped in HTML comment markers, following the
+// idempotent-marker pattern the emdbed project uses for embedding
+// generated content in a README: re-rendering into an existing document
+// updates each file's block in place instead of appending a duplicate,
+// and drops blocks for files that no longer have anything to report.
+package markdown
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/DEADSERPENT/importlens"
+)
+
+const markerEnd = "<!-- /importlens -->"
+
+var markerStartRE = regexp.MustCompile(`^<!-- importlens:(.+) L\d+-\d+ -->$`)
+
+func markerStart(file string, start, end int) string {
+	return fmt.Sprintf("<!-- importlens:%s L%d-%d -->", file, start, end)
+}
+
+// Render writes rpt as a standalone Markdown document, one section per
+// file that has findings.
+func Render(w io.Writer, rpt *importlens.Report) error {
+	sections, err := buildSections(rpt)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "# Import Hygiene")
+	for _, s := range sections {
+		fmt.Fprintf(w, "\n## %s\n\n", s.file)
+		fmt.Fprintln(w, markerStart(s.file, s.start, s.end))
+		fmt.Fprint(w, s.body)
+		fmt.Fprintln(w, markerEnd)
+	}
+	return nil
+}
+
+// Update rewrites the file at path, replacing each file's marker-bounded
+// block with rpt's current findings for it, appending a new block for a
+// file that doesn't have one yet, and dropping blocks for files rpt no
+// longer has anything to say about. A missing path is treated as empty.
+func Update(path string, rpt *importlens.Report) error {
+	sections, err := buildSections(rpt)
+	if err != nil {
+		return err
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.WriteFile(path, injectSections(existing, sections), 0o644)
+}
+
+type section struct {
+	file       string
+	start, end int // 1-based, inclusive line range of the embedded snippet
+	body       string
+}
+
+func buildSections(rpt *importlens.Report) ([]section, error) {
+	byFile := map[string][]importlens.Finding{}
+	var files []string
+	for _, f := range rpt.Findings {
+		if f.Pos.Filename == "" {
+			continue
+		}
+		if _, ok := byFile[f.Pos.Filename]; !ok {
+			files = append(files, f.Pos.Filename)
+		}
+		byFile[f.Pos.Filename] = append(byFile[f.Pos.Filename], f)
+	}
+	sort.Strings(files)
+
+	var sections []section
+	for _, file := range files {
+		findings := byFile[file]
+		sort.Slice(findings, func(i, j int) bool { return findings[i].Pos.Line < findings[j].Pos.Line })
+
+		s, err := buildSection(file, findings)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, s)
+	}
+	return sections, nil
+}
+
+func buildSection(file string, findings []importlens.Finding) (section, error) {
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return section{}, fmt.Errorf("markdown: reading %s: %w", file, err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, src, 0)
+	if err != nil {
+		return section{}, fmt.Errorf("markdown: parsing %s: %w", file, err)
+	}
+
+	start, end := importBlockRange(fset, f, findings)
+	lines := strings.Split(string(src), "\n")
+
+	var body strings.Builder
+	fmt.Fprintln(&body, "```go")
+	for _, line := range lines[start-1 : end] {
+		fmt.Fprintln(&body, line)
+	}
+	fmt.Fprintln(&body, "```")
+	for _, finding := range findings {
+		fmt.Fprintf(&body, "- L%d: %s\n", finding.Pos.Line, importlens.Format(finding, importlens.GoCompilerMode))
+	}
+
+	return section{file: file, start: start, end: end, body: body.String()}, nil
+}
+
+// importBlockRange returns the line range (1-based, inclusive) covering
+// every import declaration that owns one of findings, so the snippet is
+// the whole offending import block rather than just its flagged lines.
+func importBlockRange(fset *token.FileSet, f *ast.File, findings []importlens.Finding) (start, end int) {
+	byLine := make(map[int]bool, len(findings))
+	for _, finding := range findings {
+		byLine[finding.Pos.Line] = true
+	}
+
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		declStart, declEnd := fset.Position(gd.Pos()).Line, fset.Position(gd.End()).Line
+		match := false
+		for _, spec := range gd.Specs {
+			if byLine[fset.Position(spec.Pos()).Line] {
+				match = true
+				break
+			}
+		}
+		if !match {
+			continue
+		}
+		if start == 0 || declStart < start {
+			start = declStart
+		}
+		if declEnd > end {
+			end = declEnd
+		}
+	}
+	if start == 0 {
+		// No enclosing import decl matched (shouldn't normally happen);
+		// fall back to the findings' own line span.
+		start, end = findings[0].Pos.Line, findings[0].Pos.Line
+		for _, finding := range findings {
+			if finding.Pos.Line < start {
+				start = finding.Pos.Line
+			}
+			if finding.Pos.Line > end {
+				end = finding.Pos.Line
+			}
+		}
+	}
+	return start, end
+}
+
+func injectSections(existing []byte, sections []section) []byte {
+	byFile := make(map[string]section, len(sections))
+	for _, s := range sections {
+		byFile[s.file] = s
+	}
+	handled := make(map[string]bool, len(sections))
+
+	lines := strings.Split(string(existing), "\n")
+	var out []string
+	for i := 0; i < len(lines); i++ {
+		m := markerStartRE.FindStringSubmatch(lines[i])
+		if m == nil {
+			out = append(out, lines[i])
+			continue
+		}
+
+		j := i + 1
+		for j < len(lines) && lines[j] != markerEnd {
+			j++
+		}
+
+		if s, ok := byFile[m[1]]; ok {
+			out = append(out, markerStart(s.file, s.start, s.end))
+			out = append(out, strings.Split(strings.TrimRight(s.body, "\n"), "\n")...)
+			out = append(out, markerEnd)
+			handled[s.file] = true
+		}
+		// else: the file no longer has findings; drop the stale block.
+
+		i = j
+	}
+
+	for _, s := range sections {
+		if handled[s.file] {
+			continue
+		}
+		out = append(out, "", markerStart(s.file, s.start, s.end))
+		out = append(out, strings.Split(strings.TrimRight(s.body, "\n"), "\n")...)
+		out = append(out, markerEnd)
+	}
+
+	return []byte(strings.Join(out, "\n"))
+}
@@ -0,0 +1,105 @@
+package markdown
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DEADSERPENT/importlens"
+)
+
+func TestRender(t *testing.T) {
+	rpt, err := importlens.Analyze("../../testdata/basic")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, rpt); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, "# Import Hygiene") {
+		t.Errorf("missing document heading:\n%s", out)
+	}
+	if !strings.Contains(out, "testdata/basic/test.go") {
+		t.Errorf("missing file section:\n%s", out)
+	}
+	if !strings.Contains(out, "```go") {
+		t.Errorf("missing fenced snippet:\n%s", out)
+	}
+	if !strings.Contains(out, `"time" imported and not used`) {
+		t.Errorf("missing callout:\n%s", out)
+	}
+}
+
+func TestUpdateIsIdempotentAndInPlace(t *testing.T) {
+	rpt, err := importlens.Analyze("../../testdata/basic")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	readme := filepath.Join(t.TempDir(), "README.md")
+	if err := os.WriteFile(readme, []byte("# My Project\n\nSome intro text.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Update(readme, rpt); err != nil {
+		t.Fatalf("Update (first): %v", err)
+	}
+	first, err := os.ReadFile(readme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(first), "# My Project") {
+		t.Errorf("first update dropped existing content:\n%s", first)
+	}
+	if !strings.Contains(string(first), "imported and not used") {
+		t.Errorf("first update didn't add a block:\n%s", first)
+	}
+
+	if err := Update(readme, rpt); err != nil {
+		t.Fatalf("Update (second): %v", err)
+	}
+	second, err := os.ReadFile(readme)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(first) != string(second) {
+		t.Errorf("re-running Update changed the file; want idempotent\nfirst:\n%s\nsecond:\n%s", first, second)
+	}
+	if n := strings.Count(string(second), "<!-- importlens:"); n != 1 {
+		t.Errorf("got %d marker blocks after two updates, want 1 (no duplicate appended)", n)
+	}
+}
+
+func TestUpdateDropsStaleBlock(t *testing.T) {
+	rpt, err := importlens.Analyze("../../testdata/basic")
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+
+	readme := filepath.Join(t.TempDir(), "README.md")
+	if err := os.WriteFile(readme, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Update(readme, rpt); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	// Re-running against an empty report should remove the now-stale block.
+	if err := Update(readme, &importlens.Report{}); err != nil {
+		t.Fatalf("Update (empty report): %v", err)
+	}
+	out, err := os.ReadFile(readme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(out), "<!-- importlens:") {
+		t.Errorf("stale block was not dropped:\n%s", out)
+	}
+}
@@ -0,0 +1,65 @@
+package score
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KindConfig controls how one Kind (by its String name, e.g.
+// "UnusedImport") contributes to a package's grade.
+type KindConfig struct {
+	// Weight is subtracted from 100 once per occurrence of this kind.
+	Weight float64 `yaml:"weight"`
+	// Block forces the overall grade to F regardless of Weight once this
+	// kind occurs at all, e.g. for a team that treats an aliased unused
+	// import as blocking rather than merely penalized.
+	Block bool `yaml:"block"`
+}
+
+// Config is the shape of .importlens.yaml.
+type Config struct {
+	Weights map[string]KindConfig `yaml:"weights"`
+}
+
+// DefaultConfig mirrors the Kind docs: unused and duplicate imports cost
+// real points, a self import is heavily penalized, blank and dot imports
+// are informational only, and an unused aliased import blocks the grade
+// outright on top of its weight.
+func DefaultConfig() Config {
+	return Config{Weights: map[string]KindConfig{
+		"UnusedImport":        {Weight: 5},
+		"UnusedAliasedImport": {Weight: 5, Block: true},
+		"BlankImport":         {Weight: 0},
+		"DotImport":           {Weight: 1},
+		"DuplicateImport":     {Weight: 5},
+		"SelfImport":          {Weight: 10},
+		"DeprecatedImport":    {Weight: 3},
+		"CgoImport":           {Weight: 0},
+	}}
+}
+
+// LoadConfig reads .importlens.yaml at path and overlays it onto
+// DefaultConfig, so a team only needs to list the kinds they want to
+// change. A missing file is not an error; it just means the defaults
+// apply.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return Config{}, err
+	}
+
+	var overlay Config
+	if err := yaml.Unmarshal(data, &overlay); err != nil {
+		return Config{}, err
+	}
+	for kind, kc := range overlay.Weights {
+		cfg.Weights[kind] = kc
+	}
+	return cfg, nil
+}
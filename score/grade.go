@@ -0,0 +1,60 @@
+package score
+
+// gradeFor weights byKind (Kind.String() -> count) against cfg and
+// returns a percent in [0,100] and the letter grade for it. A count for a
+// kind with no configured weight costs nothing; a kind flagged Block
+// forces the percent to 0 as soon as it occurs once, regardless of how
+// small its own weight is.
+func gradeFor(cfg Config, byKind map[string]int) (percent float64, letter string) {
+	percent = 100
+	blocked := false
+	for kind, count := range byKind {
+		if count == 0 {
+			continue
+		}
+		kc := cfg.Weights[kind]
+		percent -= kc.Weight * float64(count)
+		if kc.Block {
+			blocked = true
+		}
+	}
+
+	if blocked {
+		return 0, letterFor(0)
+	}
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	return percent, letterFor(percent)
+}
+
+// letterFor maps a percent to a goreportcard-style letter grade.
+func letterFor(percent float64) string {
+	switch {
+	case percent >= 97:
+		return "A+"
+	case percent >= 93:
+		return "A"
+	case percent >= 90:
+		return "A-"
+	case percent >= 87:
+		return "B+"
+	case percent >= 83:
+		return "B"
+	case percent >= 80:
+		return "B-"
+	case percent >= 77:
+		return "C+"
+	case percent >= 73:
+		return "C"
+	case percent >= 70:
+		return "C-"
+	case percent >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
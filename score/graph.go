@@ -0,0 +1,41 @@
+package score
+
+// maxDepth returns the longest chain of in-module imports reachable from
+// any package in graph, i.e. the deepest an internal import actually
+// nests. Go's build rules already forbid true import cycles, so this is
+// reported as "cycle depth" in the loose sense goreportcard uses it:
+// how far a change in a leaf package can ripple before it stops
+// mattering to anything else in the module.
+func maxDepth(graph map[string][]string) int {
+	memo := make(map[string]int, len(graph))
+	var visit func(path string, onStack map[string]bool) int
+	visit = func(path string, onStack map[string]bool) int {
+		if d, ok := memo[path]; ok {
+			return d
+		}
+		if onStack[path] {
+			// A real cycle shouldn't exist; if one somehow does (e.g. via
+			// build tags the go tool wouldn't normally let coexist), don't
+			// recurse forever over it.
+			return 0
+		}
+		onStack[path] = true
+		best := 0
+		for _, dep := range graph[path] {
+			if d := visit(dep, onStack) + 1; d > best {
+				best = d
+			}
+		}
+		delete(onStack, path)
+		memo[path] = best
+		return best
+	}
+
+	max := 0
+	for path := range graph {
+		if d := visit(path, map[string]bool{}); d > max {
+			max = d
+		}
+	}
+	return max
+}
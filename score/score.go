@@ -0,0 +1,144 @@
+// Package score turns an importlens.Report for every package in a
+// module into a single weighted scorecard: a letter grade plus a stable
+// JSON breakdown suitable for gating a CI build on regressions.
+package score
+
+import (
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/DEADSERPENT/importlens"
+)
+
+// SchemaVersion identifies the shape of Result. Bump it whenever a field
+// is removed or changes meaning, so a CI job comparing scorecards across
+// commits can detect an incompatible change instead of misreading it.
+const SchemaVersion = 1
+
+// Result is the scorecard for one module, in the shape written out as
+// JSON by "importlens score".
+type Result struct {
+	SchemaVersion int `json:"schemaVersion"`
+
+	Files  int            `json:"files"`
+	Issues int            `json:"issues"`
+	ByKind map[string]int `json:"byKind"`
+
+	StdlibImports     int     `json:"stdlibImports"`
+	ThirdPartyImports int     `json:"thirdPartyImports"`
+	ThirdPartyRatio   float64 `json:"thirdPartyRatio"`
+	CycleDepth        int     `json:"cycleDepth"`
+
+	Percent float64 `json:"percent"`
+	Grade   string  `json:"grade"`
+}
+
+// Run walks every package under moduleDir, runs importlens's detectors
+// over each one, and folds the results into a Result graded against cfg.
+// moduleDir must contain the module's go.mod; internal-import-depth
+// tracking is skipped (but everything else still works) if it doesn't.
+func Run(moduleDir string, cfg Config) (*Result, error) {
+	pkgs, err := walkPackages(moduleDir)
+	if err != nil {
+		return nil, err
+	}
+
+	modulePath := readModulePath(moduleDir)
+	importPaths := make(map[string]string, len(pkgs)) // dir -> module-relative import path
+	inModule := make(map[string]bool, len(pkgs))
+	if modulePath != "" {
+		for _, p := range pkgs {
+			ip := packageImportPath(moduleDir, modulePath, p.dir)
+			importPaths[p.dir] = ip
+			inModule[ip] = true
+		}
+	}
+
+	byKind := map[string]int{}
+	graph := map[string][]string{}
+	files, stdlib, thirdParty := 0, 0, 0
+
+	for _, p := range pkgs {
+		files += p.files
+
+		rpt, err := importlens.Analyze(p.dir)
+		if err == nil {
+			for _, f := range rpt.Findings {
+				byKind[f.Kind.String()]++
+			}
+		}
+
+		var deps []string
+		for _, ip := range p.imports {
+			if ip == "C" {
+				byKind["CgoImport"]++
+				continue
+			}
+			if isStdlib(ip) {
+				stdlib++
+			} else {
+				thirdParty++
+			}
+			if inModule[ip] {
+				deps = append(deps, ip)
+			}
+		}
+		if ip, ok := importPaths[p.dir]; ok {
+			graph[ip] = deps
+		}
+	}
+
+	issues := 0
+	for _, n := range byKind {
+		issues += n
+	}
+
+	ratio := 0.0
+	if total := stdlib + thirdParty; total > 0 {
+		ratio = float64(thirdParty) / float64(total)
+	}
+
+	percent, letter := gradeFor(cfg, byKind)
+
+	return &Result{
+		SchemaVersion:     SchemaVersion,
+		Files:             files,
+		Issues:            issues,
+		ByKind:            byKind,
+		StdlibImports:     stdlib,
+		ThirdPartyImports: thirdParty,
+		ThirdPartyRatio:   ratio,
+		CycleDepth:        maxDepth(graph),
+		Percent:           percent,
+		Grade:             letter,
+	}, nil
+}
+
+// readModulePath returns the module path declared in moduleDir/go.mod,
+// or "" if it can't be read or parsed; callers treat that as "internal
+// import depth isn't available" rather than a hard failure.
+func readModulePath(moduleDir string) string {
+	path := filepath.Join(moduleDir, "go.mod")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	mf, err := modfile.Parse(path, data, nil)
+	if err != nil || mf.Module == nil {
+		return ""
+	}
+	return mf.Module.Mod.Path
+}
+
+// packageImportPath derives dir's import path the way the go tool would,
+// without needing a real modules-aware build: modulePath joined with
+// dir's slash-separated path relative to moduleDir.
+func packageImportPath(moduleDir, modulePath, dir string) string {
+	rel, err := filepath.Rel(moduleDir, dir)
+	if err != nil || rel == "." {
+		return modulePath
+	}
+	return modulePath + "/" + filepath.ToSlash(rel)
+}
@@ -0,0 +1,68 @@
+package score
+
+import "testing"
+
+func TestRun(t *testing.T) {
+	result, err := Run("testdata/fixture", DefaultConfig())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if result.SchemaVersion != SchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", result.SchemaVersion, SchemaVersion)
+	}
+	if result.Files != 2 {
+		t.Errorf("Files = %d, want 2", result.Files)
+	}
+	if result.Issues != 1 || result.ByKind["UnusedImport"] != 1 {
+		t.Errorf("Issues/ByKind = %d/%v, want 1 UnusedImport", result.Issues, result.ByKind)
+	}
+	if result.CycleDepth != 1 {
+		t.Errorf("CycleDepth = %d, want 1 (bad imports good)", result.CycleDepth)
+	}
+	if result.StdlibImports != 3 || result.ThirdPartyImports != 1 {
+		t.Errorf("StdlibImports/ThirdPartyImports = %d/%d, want 3/1", result.StdlibImports, result.ThirdPartyImports)
+	}
+	if result.Percent != 95 {
+		t.Errorf("Percent = %v, want 95", result.Percent)
+	}
+	if result.Grade != "A" {
+		t.Errorf("Grade = %q, want %q", result.Grade, "A")
+	}
+}
+
+func TestRunBlockingKindForcesF(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Weights["UnusedImport"] = KindConfig{Weight: 1, Block: true}
+
+	result, err := Run("testdata/fixture", cfg)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Percent != 0 || result.Grade != "F" {
+		t.Errorf("Percent/Grade = %v/%q, want 0/F", result.Percent, result.Grade)
+	}
+}
+
+func TestRunCgoImport(t *testing.T) {
+	result, err := Run("testdata/cgofixture", DefaultConfig())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Files != 1 {
+		t.Errorf("Files = %d, want 1", result.Files)
+	}
+	if result.ByKind["CgoImport"] != 1 {
+		t.Errorf("ByKind[CgoImport] = %d, want 1: %+v", result.ByKind["CgoImport"], result.ByKind)
+	}
+}
+
+func TestLoadConfigMissingFileUsesDefaults(t *testing.T) {
+	cfg, err := LoadConfig("testdata/does-not-exist.yaml")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.Weights["SelfImport"].Weight != DefaultConfig().Weights["SelfImport"].Weight {
+		t.Errorf("LoadConfig of a missing file didn't fall back to defaults: %+v", cfg)
+	}
+}
@@ -0,0 +1,10 @@
+package pkg
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+// Noop exists only so this package has something other than the cgo
+// preamble to it.
+func Noop() {}
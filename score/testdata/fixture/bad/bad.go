@@ -0,0 +1,13 @@
+package bad
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/fixture/good"
+)
+
+// Run has an unused "fmt" import on purpose, for the score fixture.
+func Run() string {
+	return good.Shout("hi") + strings.Repeat("!", 1)
+}
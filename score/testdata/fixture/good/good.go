@@ -0,0 +1,8 @@
+package good
+
+import "strings"
+
+// Shout upper-cases s.
+func Shout(s string) string {
+	return strings.ToUpper(s)
+}
@@ -0,0 +1,99 @@
+package score
+
+import (
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// pkgInfo is what Run needs from a single package directory: enough to
+// run the regular detectors on it, and enough to compute the
+// repository-wide metrics (stdlib ratio, cgo, internal import depth)
+// without re-parsing.
+type pkgInfo struct {
+	dir     string
+	files   int
+	imports []string // deduplicated, in first-seen order
+}
+
+// walkPackages finds every Go package under root, skipping dot and
+// underscore directories, vendor, and testdata, the same directories
+// the go tool itself ignores for "./..." patterns.
+func walkPackages(root string) ([]pkgInfo, error) {
+	var pkgs []pkgInfo
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if name := d.Name(); path != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata") {
+			return filepath.SkipDir
+		}
+
+		info, ok, err := loadPkgInfo(path)
+		if err != nil {
+			return err
+		}
+		if ok {
+			pkgs = append(pkgs, info)
+		}
+		return nil
+	})
+	return pkgs, err
+}
+
+func loadPkgInfo(dir string) (pkgInfo, bool, error) {
+	bp, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		if _, ok := err.(*build.NoGoError); ok {
+			return pkgInfo{}, false, nil
+		}
+		return pkgInfo{}, false, err
+	}
+
+	// CgoFiles (files with "import \"C\"") are reported separately from
+	// GoFiles whenever cgo is enabled, which is the default on any
+	// machine with a C toolchain; skipping them would make importlens
+	// blind to cgo-adjacent packages on a normal contributor's machine.
+	names := make([]string, 0, len(bp.GoFiles)+len(bp.CgoFiles)+len(bp.TestGoFiles))
+	names = append(names, bp.GoFiles...)
+	names = append(names, bp.CgoFiles...)
+	names = append(names, bp.TestGoFiles...)
+
+	fset := token.NewFileSet()
+	seen := make(map[string]bool)
+	var imports []string
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ImportsOnly)
+		if err != nil {
+			continue // a file the rest of the toolchain will also reject
+		}
+		for _, spec := range f.Imports {
+			p, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || seen[p] {
+				continue
+			}
+			seen[p] = true
+			imports = append(imports, p)
+		}
+	}
+
+	return pkgInfo{dir: dir, files: len(names), imports: imports}, true, nil
+}
+
+// isStdlib reports whether importPath looks like a standard library
+// path: its first path element has no dot, the same heuristic
+// goimports uses to group imports.
+func isStdlib(importPath string) bool {
+	first := importPath
+	if i := strings.IndexByte(importPath, '/'); i >= 0 {
+		first = importPath[:i]
+	}
+	return !strings.Contains(first, ".")
+}
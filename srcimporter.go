@@ -0,0 +1,87 @@
+package importlens
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+)
+
+// srcImporter resolves import paths by parsing and type-checking the
+// package's source, the same way go/internal/srcimporter does. Results
+// are cached so that a dependency imported from several files, or by
+// several packages, is only loaded and type-checked once.
+//
+// When a package's source can't be located (for example a binary-only
+// package, or a build configuration importlens doesn't understand), it
+// falls back to importer.Default(), which reads export data instead.
+type srcImporter struct {
+	fset      *token.FileSet
+	ctx       build.Context
+	srcDir    string
+	pkgs      map[string]*types.Package
+	importing map[string]bool // import paths currently being type-checked
+	fallback  types.ImporterFrom
+}
+
+func newSrcImporter(fset *token.FileSet, srcDir string) *srcImporter {
+	return &srcImporter{
+		fset:      fset,
+		ctx:       build.Default,
+		srcDir:    srcDir,
+		pkgs:      map[string]*types.Package{"unsafe": types.Unsafe},
+		importing: make(map[string]bool),
+		fallback:  importer.Default().(types.ImporterFrom),
+	}
+}
+
+// Import implements types.Importer.
+func (imp *srcImporter) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, imp.srcDir, 0)
+}
+
+// ImportFrom implements types.ImporterFrom.
+func (imp *srcImporter) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	if pkg, ok := imp.pkgs[path]; ok && pkg.Complete() {
+		return pkg, nil
+	}
+
+	// A self-import (or, in principle, a real import cycle) would
+	// otherwise recurse into ImportFrom forever: the package isn't
+	// cached yet because we haven't finished type-checking it.
+	if imp.importing[path] {
+		return nil, fmt.Errorf("import cycle not allowed: %s", path)
+	}
+
+	bp, err := imp.ctx.Import(path, dir, 0)
+	if err != nil {
+		// No source available (e.g. a binary-only package); fall back to
+		// export data rather than failing the whole analysis.
+		return imp.fallback.ImportFrom(path, dir, mode)
+	}
+
+	files := make([]*ast.File, 0, len(bp.GoFiles))
+	for _, name := range bp.GoFiles {
+		f, err := parser.ParseFile(imp.fset, filepath.Join(bp.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("importlens: parsing %s: %w", name, err)
+		}
+		files = append(files, f)
+	}
+
+	imp.importing[path] = true
+	defer delete(imp.importing, path)
+
+	cfg := types.Config{Importer: imp}
+	pkg, err := cfg.Check(path, imp.fset, files, nil)
+	if err != nil {
+		return nil, fmt.Errorf("importlens: type-checking %s: %w", path, err)
+	}
+
+	imp.pkgs[path] = pkg
+	return pkg, nil
+}
@@ -2,10 +2,10 @@
 package main
 
 import (
+	_ "database/sql"
 	"fmt"
 	"strings"
 	"time"
-	_ "database/sql"
 )
 
 // Using: fmt, strings, database/sql (blank import)
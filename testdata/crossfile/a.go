@@ -0,0 +1,10 @@
+// Package crossfile is a fixture with two files sharing one package:
+// a.go's import of "fmt" must not be flagged unused just because b.go,
+// in the same package, doesn't reference it.
+package crossfile
+
+import "fmt"
+
+func Hello() string {
+	return fmt.Sprintf("hello")
+}
@@ -0,0 +1,5 @@
+package crossfile
+
+func World() string {
+	return "world"
+}
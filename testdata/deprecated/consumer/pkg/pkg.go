@@ -0,0 +1,8 @@
+// Package pkg is a fixture for Analyze's deprecated-module detection:
+// it imports a module whose go.mod, in the fake GOMODCACHE this test
+// points at, is marked deprecated.
+package pkg
+
+import _ "example.com/gone/sub"
+
+func F() {}
@@ -0,0 +1,8 @@
+// Package dot is a fixture exercising a dot import: ToUpper is used
+// unqualified, so the import is used even though "strings" never
+// appears as an identifier in the file.
+package dot
+
+import . "strings"
+
+var shout = ToUpper("hi")
@@ -0,0 +1,14 @@
+// Package duplicate is a fixture: "fmt" is imported twice, once under
+// its default name and once aliased, which real Go rejects but
+// importlens should still flag on its own terms.
+package duplicate
+
+import (
+	"fmt"
+	f "fmt"
+)
+
+func run() {
+	fmt.Println("hi")
+	f.Println("hi")
+}
@@ -0,0 +1,13 @@
+// Package selfimport is a fixture with an import of its own import
+// path, the kind of copy-paste mistake SelfImport exists to catch.
+package selfimport
+
+import (
+	"fmt"
+
+	"github.com/DEADSERPENT/importlens/testdata/selfimport"
+)
+
+func Hello() string {
+	return fmt.Sprintf("hi")
+}
@@ -0,0 +1,11 @@
+// Package shadow is a fixture: its only import is shadowed by a local
+// variable of the same name, so the import is unused despite the
+// identifier "fmt" appearing in the file.
+package shadow
+
+import "fmt"
+
+func run() string {
+	fmt := "shadowed"
+	return fmt
+}